@@ -0,0 +1,85 @@
+// Command gather is a must-gather tool for the kube-apiserver control
+// plane: it dumps Pods, Deployments, ConfigMaps, Events, container logs,
+// and cluster-scoped resources to disk for offline inspection.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/tnierman/example-managed-job/pkg/gather"
+)
+
+// Environment variables read when their matching flag isn't set
+// explicitly, so downstream operators can reuse this binary without
+// having to template a flag list.
+const (
+	namespacesEnvVar = "GATHER_NAMESPACES"
+	resourcesEnvVar  = "GATHER_RESOURCES"
+)
+
+func main() {
+	var (
+		outDir       string
+		namespaces   string
+		resources    string
+		previousLogs bool
+	)
+
+	flag.StringVar(&outDir, "output-dir", "must-gather", "directory to write gathered state to")
+	flag.StringVar(&namespaces, "namespaces", envOrDefault(namespacesEnvVar, gather.DefaultNamespaces), "comma-separated list of namespaces to gather (env: "+namespacesEnvVar+")")
+	flag.StringVar(&resources, "resources", envOrDefault(resourcesEnvVar, gather.DefaultResources), "comma-separated list of namespaced resource kinds to gather (env: "+resourcesEnvVar+")")
+	flag.BoolVar(&previousLogs, "previous-logs", true, "also gather previous-container logs")
+	flag.Parse()
+
+	cfg := ctrl.GetConfigOrDie()
+
+	client, err := kclient.New(cfg, kclient.Options{})
+	if err != nil {
+		log.Fatalf("Couldn't create client: %v", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		log.Fatalf("Couldn't create clientset: %v", err)
+	}
+
+	g, err := gather.NewGatherer(client, clientset, gather.Config{
+		OutDir:       outDir,
+		Namespaces:   splitNonEmpty(namespaces),
+		Resources:    splitNonEmpty(resources),
+		PreviousLogs: previousLogs,
+	})
+	if err != nil {
+		log.Fatalf("Couldn't create gatherer: %v", err)
+	}
+
+	log.Printf("Gathering cluster state into %q", outDir)
+	if err := g.Run(context.Background()); err != nil {
+		log.Fatalf("Gather failed: %v", err)
+	}
+	log.Println("Gather complete")
+}
+
+func splitNonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// envOrDefault returns the comma-separated value of envVar if set,
+// otherwise def joined with commas.
+func envOrDefault(envVar string, def []string) string {
+	if v, ok := os.LookupEnv(envVar); ok {
+		return v
+	}
+	return strings.Join(def, ",")
+}
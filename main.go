@@ -1,31 +1,59 @@
 package main
 
 import (
-	"context"
 	"fmt"
-	"log"
+	"os"
 
-	corev1 "k8s.io/api/core/v1"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/tnierman/example-managed-job/pkg/cli/job"
 )
 
 func main() {
-	log.Println("Creating the client")
+	root := &cobra.Command{
+		Use:   "example-managed-job",
+		Short: "Watches and remediates kube-apiserver Pods",
+	}
 
-	client, err := kclient.New(ctrl.GetConfigOrDie(), kclient.Options{})
-	if err != nil {
-	        log.Fatalf("Couldn't create client: %v", err)
+	root.AddCommand(newManageCommand())
+	root.AddCommand(newJobCommand())
+
+	if err := root.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
+}
 
-	log.Println("Looking for kube-apiserver pods...")
-	pods := corev1.PodList{}
-	err = client.List(context.TODO(), &pods, &kclient.ListOptions{Namespace: "openshift-kube-apiserver"})
-	if err != nil {
-		log.Fatalf("Couldn't list pods in 'openshift-kube-apiserver': %v", err)
+// newJobCommand returns the "job" command tree operating on the Jobs
+// this tool creates.
+func newJobCommand() *cobra.Command {
+	flags := &job.Flags{}
+	cmd := &cobra.Command{
+		Use:   "job",
+		Short: "Manage Jobs created by this tool",
 	}
-	log.Println("Pods in 'openshift-kube-apiserver': ")
-	for _, pod := range pods.Items {
-		fmt.Println("- ", pod.Name)
+	job.InitFlags(cmd, flags)
+
+	cmd.AddCommand(job.NewRunCommand(flags, getRESTConfig))
+	cmd.AddCommand(job.NewListCommand(flags, getClient))
+	cmd.AddCommand(job.NewSuspendCommand(flags, getClient))
+	cmd.AddCommand(job.NewResumeCommand(flags, getClient))
+	cmd.AddCommand(job.NewDeleteCommand(flags, getClient))
+
+	return cmd
+}
+
+func getRESTConfig() (*rest.Config, error) {
+	return ctrl.GetConfig()
+}
+
+func getClient() (kclient.Client, error) {
+	cfg, err := getRESTConfig()
+	if err != nil {
+		return nil, err
 	}
+	return kclient.New(cfg, kclient.Options{})
 }
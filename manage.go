@@ -0,0 +1,83 @@
+package main
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/tnierman/example-managed-job/pkg/controller"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+}
+
+// newManageCommand returns the "manage" command: it runs the
+// controller-runtime manager that reconciles kube-apiserver Pods as a
+// long-running managed workload.
+func newManageCommand() *cobra.Command {
+	var (
+		metricsAddr          string
+		probeAddr            string
+		enableLeaderElection bool
+		labelSelector        string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "manage",
+		Short: "Run the controller that watches kube-apiserver Pods",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runManager(metricsAddr, probeAddr, enableLeaderElection, labelSelector)
+		},
+	}
+
+	cmd.Flags().StringVar(&metricsAddr, "metrics-bind-address", ":8080", "address the metrics endpoint binds to")
+	cmd.Flags().StringVar(&probeAddr, "health-probe-bind-address", ":8081", "address the health/readiness probe endpoint binds to")
+	cmd.Flags().BoolVar(&enableLeaderElection, "leader-elect", false, "enable leader election for controller manager")
+	cmd.Flags().StringVar(&labelSelector, "pod-label-selector", "", "label selector restricting which kube-apiserver pods are reconciled")
+
+	return cmd
+}
+
+func runManager(metricsAddr, probeAddr string, enableLeaderElection bool, labelSelector string) error {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return err
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: metricsAddr},
+		HealthProbeBindAddress: probeAddr,
+		LeaderElection:         enableLeaderElection,
+		LeaderElectionID:       "example-managed-job.openshift.io",
+	})
+	if err != nil {
+		return err
+	}
+
+	reconciler := controller.NewPodReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("example-managed-job"))
+	if err := reconciler.SetupWithManager(mgr, selector); err != nil {
+		return err
+	}
+
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		return err
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		return err
+	}
+
+	log.Println("Starting manager")
+	return mgr.Start(ctrl.SetupSignalHandler())
+}
@@ -0,0 +1,39 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewDeleteCommand returns the "job delete" subcommand.
+func NewDeleteCommand(flags *Flags, client func() (kclient.Client, error)) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <job-name>",
+		Short: "Delete a Job created by this tool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := client()
+			if err != nil {
+				return err
+			}
+			return RunDelete(cmd.Context(), c, flags, args[0])
+		},
+	}
+}
+
+// RunDelete deletes the named managed Job, propagating to its Pods.
+func RunDelete(ctx context.Context, c kclient.Client, flags *Flags, name string) error {
+	j, err := getManagedJob(ctx, c, flags, name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Delete(ctx, j, kclient.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		return fmt.Errorf("couldn't delete job %q: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Package job implements the "job" subcommands (run, list, suspend,
+// resume, delete) operating on the batchv1.Jobs this tool creates: a
+// shared flag/client helper plus one small file per verb so new verbs
+// can be added without touching main.
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/tnierman/example-managed-job/pkg/jobrunner"
+)
+
+// Flags holds the persistent flags shared by every job subcommand.
+type Flags struct {
+	Namespace string
+}
+
+// InitFlags registers the flags shared by every job subcommand onto cmd.
+func InitFlags(cmd *cobra.Command, flags *Flags) {
+	cmd.PersistentFlags().StringVarP(&flags.Namespace, "namespace", "n", "openshift-kube-apiserver", "namespace the managed Jobs live in")
+}
+
+// managedJobs lists every Job in flags.Namespace that this tool created.
+func managedJobs(ctx context.Context, c kclient.Client, flags *Flags) (*batchv1.JobList, error) {
+	list := &batchv1.JobList{}
+	err := c.List(ctx, list,
+		kclient.InNamespace(flags.Namespace),
+		kclient.MatchingLabels{jobrunner.ManagedByLabel: jobrunner.ManagedByValue},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list managed jobs: %w", err)
+	}
+	return list, nil
+}
+
+// getManagedJob fetches a single managed Job by name, erroring if it
+// wasn't created by this tool.
+func getManagedJob(ctx context.Context, c kclient.Client, flags *Flags, name string) (*batchv1.Job, error) {
+	j := &batchv1.Job{}
+	if err := c.Get(ctx, kclient.ObjectKey{Namespace: flags.Namespace, Name: name}, j); err != nil {
+		return nil, fmt.Errorf("couldn't get job %q: %w", name, err)
+	}
+	if j.Labels[jobrunner.ManagedByLabel] != jobrunner.ManagedByValue {
+		return nil, fmt.Errorf("job %q is not managed by %s", name, jobrunner.ManagedByValue)
+	}
+	return j, nil
+}
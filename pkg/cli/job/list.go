@@ -0,0 +1,68 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewListCommand returns the "job list" subcommand.
+func NewListCommand(flags *Flags, client func() (kclient.Client, error)) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List Jobs created by this tool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := client()
+			if err != nil {
+				return err
+			}
+			return RunList(cmd.Context(), c, flags)
+		},
+	}
+}
+
+// RunList prints a table of managed Jobs: name, namespace, phase, and pod
+// status.
+func RunList(ctx context.Context, c kclient.Client, flags *Flags) error {
+	jobs, err := managedJobs(ctx, c, flags)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tNAMESPACE\tPHASE\tPODS (ACTIVE/SUCCEEDED/FAILED)")
+	for _, j := range jobs.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d/%d\n",
+			j.Name, j.Namespace, phaseOf(&j), j.Status.Active, j.Status.Succeeded, j.Status.Failed)
+	}
+	return w.Flush()
+}
+
+// phaseOf derives a human-readable phase from a Job's spec and status,
+// since batchv1.Job has no single phase field of its own.
+func phaseOf(j *batchv1.Job) string {
+	if j.Spec.Suspend != nil && *j.Spec.Suspend {
+		return "Suspended"
+	}
+	for _, cond := range j.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return "Complete"
+		case batchv1.JobFailed:
+			return "Failed"
+		}
+	}
+	if j.Status.Active > 0 {
+		return "Running"
+	}
+	return "Pending"
+}
@@ -0,0 +1,56 @@
+package job
+
+import (
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPhaseOf(t *testing.T) {
+	trueVal := true
+
+	cases := []struct {
+		name string
+		job  *batchv1.Job
+		want string
+	}{
+		{
+			name: "suspended",
+			job:  &batchv1.Job{Spec: batchv1.JobSpec{Suspend: &trueVal}},
+			want: "Suspended",
+		},
+		{
+			name: "complete",
+			job: &batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			}}},
+			want: "Complete",
+		},
+		{
+			name: "failed",
+			job: &batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue},
+			}}},
+			want: "Failed",
+		},
+		{
+			name: "active pods, no terminal condition",
+			job:  &batchv1.Job{Status: batchv1.JobStatus{Active: 1}},
+			want: "Running",
+		},
+		{
+			name: "no pods, no conditions",
+			job:  &batchv1.Job{},
+			want: "Pending",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := phaseOf(tc.job); got != tc.want {
+				t.Errorf("phaseOf() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewResumeCommand returns the "job resume" subcommand.
+func NewResumeCommand(flags *Flags, client func() (kclient.Client, error)) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resume <job-name>",
+		Short: "Resume a previously suspended Job created by this tool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := client()
+			if err != nil {
+				return err
+			}
+			return RunResume(cmd.Context(), c, flags, args[0])
+		},
+	}
+}
+
+// RunResume clears spec.suspend on the named managed Job.
+func RunResume(ctx context.Context, c kclient.Client, flags *Flags, name string) error {
+	j, err := getManagedJob(ctx, c, flags, name)
+	if err != nil {
+		return err
+	}
+
+	resume := false
+	patch := kclient.MergeFrom(j.DeepCopy())
+	j.Spec.Suspend = &resume
+	if err := c.Patch(ctx, j, patch); err != nil {
+		return fmt.Errorf("couldn't resume job %q: %w", name, err)
+	}
+	return nil
+}
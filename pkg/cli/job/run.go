@@ -0,0 +1,142 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/tnierman/example-managed-job/pkg/jobrunner"
+	"github.com/tnierman/example-managed-job/pkg/notify"
+)
+
+// RunFlags holds the flags specific to the "job run" subcommand.
+type RunFlags struct {
+	Image        string
+	Command      []string
+	Concurrency  int
+	NotifyConfig string
+	ClusterName  string
+}
+
+// InitRunFlags registers the "job run" specific flags onto cmd.
+func InitRunFlags(cmd *cobra.Command, flags *RunFlags) {
+	cmd.Flags().StringVar(&flags.Image, "image", "", "container image the remediation Job runs")
+	cmd.Flags().StringSliceVar(&flags.Command, "command", nil, "command run inside --image; the target pod name is appended")
+	cmd.Flags().IntVar(&flags.Concurrency, "concurrency", 1, "maximum number of remediation Jobs in-flight at once")
+	cmd.Flags().StringVar(&flags.NotifyConfig, "notify-config", "", "path to a YAML file configuring post-run notification sinks")
+	cmd.Flags().StringVar(&flags.ClusterName, "cluster-name", "", "cluster name included in the post-run notification summary")
+}
+
+// NewRunCommand returns the "job run" subcommand: it discovers
+// kube-apiserver Pods and dispatches a remediation Job for each one.
+func NewRunCommand(flags *Flags, restConfig func() (*rest.Config, error)) *cobra.Command {
+	runFlags := &RunFlags{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Discover kube-apiserver Pods and dispatch a remediation Job for each one",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := restConfig()
+			if err != nil {
+				return err
+			}
+
+			c, err := kclient.New(cfg, kclient.Options{})
+			if err != nil {
+				return fmt.Errorf("couldn't create client: %w", err)
+			}
+
+			cs, err := kubernetes.NewForConfig(cfg)
+			if err != nil {
+				return fmt.Errorf("couldn't create clientset: %w", err)
+			}
+
+			return RunRun(cmd.Context(), c, cs, cfg, flags, runFlags)
+		},
+	}
+	InitRunFlags(cmd, runFlags)
+	return cmd
+}
+
+// RunRun lists the Pods in flags.Namespace, dispatches a remediation Job
+// for each one via jobrunner.Dispatcher, and - if runFlags.NotifyConfig
+// is set - sends a summary of the run to the configured notification
+// sinks.
+func RunRun(ctx context.Context, c kclient.Client, cs kubernetes.Interface, restConfig *rest.Config, flags *Flags, runFlags *RunFlags) error {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, kclient.InNamespace(flags.Namespace)); err != nil {
+		return fmt.Errorf("couldn't list pods in %q: %w", flags.Namespace, err)
+	}
+
+	dispatcher := jobrunner.NewDispatcher(c, cs, restConfig, jobrunner.Config{
+		Namespace:   flags.Namespace,
+		Image:       runFlags.Image,
+		Command:     runFlags.Command,
+		Concurrency: runFlags.Concurrency,
+	})
+
+	results := dispatcher.Dispatch(ctx, pods.Items)
+
+	if runFlags.NotifyConfig != "" {
+		if err := sendSummary(ctx, runFlags.ClusterName, flags.Namespace, pods.Items, results, runFlags.NotifyConfig); err != nil {
+			log.Printf("couldn't send run summary: %v", err)
+		}
+	}
+
+	for _, r := range results {
+		if r.Err != nil {
+			return fmt.Errorf("pod %s: %w", r.PodName, r.Err)
+		}
+	}
+	return nil
+}
+
+func sendSummary(ctx context.Context, clusterName, namespace string, pods []corev1.Pod, results []jobrunner.Result, notifyConfigPath string) error {
+	cfg, err := notify.LoadConfig(notifyConfigPath)
+	if err != nil {
+		return err
+	}
+
+	notifiers, err := notify.Build(cfg)
+	if err != nil {
+		return err
+	}
+
+	summary := notify.Summary{ClusterName: clusterName, Namespace: namespace}
+	for _, pod := range pods {
+		summary.PodsFound = append(summary.PodsFound, pod.Name)
+		if !isReady(pod) {
+			summary.NotReady = append(summary.NotReady, pod.Name)
+		}
+	}
+	for _, r := range results {
+		jr := notify.JobResult{PodName: r.PodName, Success: r.Err == nil}
+		if r.Err != nil {
+			jr.Error = r.Err.Error()
+		}
+		summary.JobResults = append(summary.JobResults, jr)
+	}
+
+	errs := notify.FanOut(ctx, notifiers, summary, "", notify.Options{Timeout: 10 * time.Second, Retries: 1})
+	for i, err := range errs {
+		if err != nil {
+			log.Printf("notifier %d failed: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func isReady(pod corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewSuspendCommand returns the "job suspend" subcommand.
+func NewSuspendCommand(flags *Flags, client func() (kclient.Client, error)) *cobra.Command {
+	return &cobra.Command{
+		Use:   "suspend <job-name>",
+		Short: "Suspend a Job created by this tool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := client()
+			if err != nil {
+				return err
+			}
+			return RunSuspend(cmd.Context(), c, flags, args[0])
+		},
+	}
+}
+
+// RunSuspend sets spec.suspend=true on the named managed Job, which causes
+// the Job controller to delete its active Pods.
+func RunSuspend(ctx context.Context, c kclient.Client, flags *Flags, name string) error {
+	j, err := getManagedJob(ctx, c, flags, name)
+	if err != nil {
+		return err
+	}
+
+	suspend := true
+	patch := kclient.MergeFrom(j.DeepCopy())
+	j.Spec.Suspend = &suspend
+	if err := c.Patch(ctx, j, patch); err != nil {
+		return fmt.Errorf("couldn't suspend job %q: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Package controller contains the controller-runtime reconcilers backing
+// this binary's long-running managed workload.
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Namespace is the namespace the PodReconciler watches.
+const Namespace = "openshift-kube-apiserver"
+
+var (
+	podReady = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "apiserver_pod_ready",
+		Help: "Whether a kube-apiserver pod is currently Ready (1) or not (0).",
+	}, []string{"pod"})
+
+	podRestartTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "apiserver_pod_restart_total",
+		Help: "Total observed container restarts for a kube-apiserver pod.",
+	}, []string{"pod"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(podReady, podRestartTotal)
+}
+
+// PodReconciler watches kube-apiserver Pods, records ready/not-ready
+// transitions as Events, and keeps the apiserver_pod_ready and
+// apiserver_pod_restart_total metrics up to date.
+type PodReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+
+	// lastReady tracks the last-observed readiness of each pod so we only
+	// emit an Event on a transition, not on every reconcile.
+	lastReady map[types.NamespacedName]bool
+	// lastRestarts tracks the last-observed total container restart count
+	// per pod so podRestartTotal only advances by the new restarts.
+	lastRestarts map[types.NamespacedName]int32
+}
+
+// NewPodReconciler returns a PodReconciler ready to be registered with a
+// Manager via SetupWithManager.
+func NewPodReconciler(c client.Client, recorder record.EventRecorder) *PodReconciler {
+	return &PodReconciler{
+		Client:       c,
+		Recorder:     recorder,
+		lastReady:    map[types.NamespacedName]bool{},
+		lastRestarts: map[types.NamespacedName]int32{},
+	}
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	pod := &corev1.Pod{}
+	if err := r.Get(ctx, req.NamespacedName, pod); err != nil {
+		if errors.IsNotFound(err) {
+			podReady.DeleteLabelValues(req.Name)
+			delete(r.lastReady, req.NamespacedName)
+			delete(r.lastRestarts, req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("couldn't get pod %s: %w", req.NamespacedName, err)
+	}
+
+	ready := isPodReady(pod)
+	if was, ok := r.lastReady[req.NamespacedName]; !ok || was != ready {
+		r.recordTransition(pod, ready)
+	}
+	r.lastReady[req.NamespacedName] = ready
+
+	if ready {
+		podReady.WithLabelValues(pod.Name).Set(1)
+	} else {
+		podReady.WithLabelValues(pod.Name).Set(0)
+	}
+
+	var restarts int32
+	for _, cs := range pod.Status.ContainerStatuses {
+		restarts += cs.RestartCount
+	}
+	// Only add the delta once we have a prior observation for this pod: the
+	// first reconcile after this reconciler starts (whether the pod is
+	// genuinely new or just rediscovered after a controller restart) should
+	// establish the baseline rather than re-adding the pod's entire
+	// historical restart count.
+	if last, ok := r.lastRestarts[req.NamespacedName]; ok {
+		if delta := restarts - last; delta > 0 {
+			podRestartTotal.WithLabelValues(pod.Name).Add(float64(delta))
+		}
+	}
+	r.lastRestarts[req.NamespacedName] = restarts
+
+	return ctrl.Result{}, nil
+}
+
+func (r *PodReconciler) recordTransition(pod *corev1.Pod, ready bool) {
+	if ready {
+		r.Recorder.Event(pod, corev1.EventTypeNormal, "PodReady", "kube-apiserver pod became Ready")
+	} else {
+		r.Recorder.Event(pod, corev1.EventTypeWarning, "PodNotReady", "kube-apiserver pod is not Ready")
+	}
+}
+
+// SetupWithManager registers the PodReconciler with mgr, restricting its
+// watch to Pods in Namespace that match selector (an empty selector
+// matches every Pod in the namespace).
+func (r *PodReconciler) SetupWithManager(mgr ctrl.Manager, selector labels.Selector) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Pod{}, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				if obj.GetNamespace() != Namespace {
+					return false
+				}
+				return selector.Matches(labels.Set(obj.GetLabels()))
+			}),
+		)).
+		Complete(r)
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
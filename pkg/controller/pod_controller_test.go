@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsPodReady(t *testing.T) {
+	cases := []struct {
+		name string
+		pod  *corev1.Pod
+		want bool
+	}{
+		{
+			name: "ready condition true",
+			pod: &corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+			}}},
+			want: true,
+		},
+		{
+			name: "ready condition false",
+			pod: &corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+			}}},
+			want: false,
+		},
+		{
+			name: "no ready condition",
+			pod: &corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+			}}},
+			want: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isPodReady(tc.pod); got != tc.want {
+				t.Errorf("isPodReady() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func newTestPod(name string, ready bool, restarts int32) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: Namespace},
+		Status: corev1.PodStatus{
+			Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "apiserver", RestartCount: restarts}},
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	pod := newTestPod("apiserver-reconcile", false, 0)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewPodReconciler(c, recorder)
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace}}
+	ctx := context.Background()
+
+	// First reconcile: not-Ready, 0 restarts. This establishes the
+	// baseline, so it must record exactly one PodNotReady event and must
+	// not touch podRestartTotal.
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if got := testutil.ToFloat64(podRestartTotal.WithLabelValues(pod.Name)); got != 0 {
+		t.Fatalf("podRestartTotal after baseline reconcile = %v, want 0", got)
+	}
+
+	// Reconciling again with no change must not emit another event.
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	// Pod becomes Ready with 2 new restarts: expect exactly one transition
+	// event and podRestartTotal to advance by the delta (2), not by the
+	// full restart count.
+	pod.Status.Conditions[0].Status = corev1.ConditionTrue
+	pod.Status.ContainerStatuses[0].RestartCount = 2
+	if err := c.Status().Update(ctx, pod); err != nil {
+		t.Fatalf("couldn't update pod: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if got := testutil.ToFloat64(podRestartTotal.WithLabelValues(pod.Name)); got != 2 {
+		t.Fatalf("podRestartTotal after restart delta = %v, want 2", got)
+	}
+
+	close(recorder.Events)
+	var events []string
+	for e := range recorder.Events {
+		events = append(events, e)
+	}
+	if len(events) != 2 {
+		t.Fatalf("recorded %d events (%v), want exactly 2 (one per transition)", len(events), events)
+	}
+
+	// Pod deletion: the NotFound path should clear both maps and the
+	// podReady gauge for this pod.
+	countBefore := testutil.CollectAndCount(podReady)
+	if err := c.Delete(ctx, pod); err != nil {
+		t.Fatalf("couldn't delete pod: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if countAfter := testutil.CollectAndCount(podReady); countAfter != countBefore-1 {
+		t.Fatalf("podReady series count after delete = %d, want %d", countAfter, countBefore-1)
+	}
+	if _, ok := r.lastReady[req.NamespacedName]; ok {
+		t.Errorf("lastReady entry for %s not cleared on NotFound", req.NamespacedName)
+	}
+	if _, ok := r.lastRestarts[req.NamespacedName]; ok {
+		t.Errorf("lastRestarts entry for %s not cleared on NotFound", req.NamespacedName)
+	}
+}
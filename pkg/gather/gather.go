@@ -0,0 +1,206 @@
+// Package gather implements a must-gather tool that dumps control-plane
+// state to disk for offline inspection: a cluster writer package driven
+// by a small cmd/gather entrypoint.
+package gather
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultNamespaces is the set of namespaces gathered when the caller
+// doesn't configure one explicitly.
+var DefaultNamespaces = []string{
+	"openshift-kube-apiserver",
+	"openshift-kube-apiserver-operator",
+}
+
+// DefaultResources is the set of namespaced resource kinds gathered when
+// the caller doesn't configure one explicitly.
+var DefaultResources = []string{"pods", "deployments", "configmaps", "events"}
+
+// Config controls which resources a Gatherer collects.
+type Config struct {
+	// OutDir is the must-gather output root.
+	OutDir string
+	// Namespaces is the list of namespaces to gather namespaced resources
+	// from. Defaults to DefaultNamespaces.
+	Namespaces []string
+	// Resources is the set of namespaced resource kinds to dump for each
+	// namespace. Defaults to DefaultResources.
+	Resources []string
+	// PreviousLogs controls whether previous-container logs are collected
+	// in addition to current-container logs.
+	PreviousLogs bool
+}
+
+// Gatherer collects cluster state using a controller-runtime client for
+// listing objects and a typed clientset for the pods/log subresource.
+type Gatherer struct {
+	Client    kclient.Client
+	Clientset kubernetes.Interface
+	Writer    *ClusterWriter
+	Config    Config
+}
+
+// NewGatherer builds a Gatherer writing into cfg.OutDir, filling in any
+// unset Config fields with their defaults.
+func NewGatherer(c kclient.Client, cs kubernetes.Interface, cfg Config) (*Gatherer, error) {
+	if len(cfg.Namespaces) == 0 {
+		cfg.Namespaces = DefaultNamespaces
+	}
+	if len(cfg.Resources) == 0 {
+		cfg.Resources = DefaultResources
+	}
+
+	w, err := NewClusterWriter(cfg.OutDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Gatherer{Client: c, Clientset: cs, Writer: w, Config: cfg}, nil
+}
+
+// Run walks Config.Namespaces, persisting the configured resource kinds
+// and container logs for every namespace, then persists cluster-scoped
+// resources. Gathering is best-effort: a missing permission or failed
+// list for one namespace/resource is logged and skipped rather than
+// aborting the rest of the run.
+func (g *Gatherer) Run(ctx context.Context) error {
+	for _, ns := range g.Config.Namespaces {
+		log.Printf("gathering namespace %q", ns)
+		g.gatherNamespace(ctx, ns)
+	}
+
+	if err := g.gatherClusterScoped(ctx); err != nil {
+		log.Printf("couldn't gather cluster-scoped resources: %v", err)
+	}
+
+	return nil
+}
+
+func (g *Gatherer) gatherNamespace(ctx context.Context, ns string) {
+	for _, resource := range g.Config.Resources {
+		if err := g.gatherResource(ctx, ns, resource); err != nil {
+			log.Printf("couldn't gather %q in namespace %q: %v", resource, ns, err)
+		}
+	}
+	if err := g.gatherPodLogs(ctx, ns); err != nil {
+		log.Printf("couldn't gather pod logs in namespace %q: %v", ns, err)
+	}
+}
+
+func (g *Gatherer) gatherResource(ctx context.Context, ns, resource string) error {
+	opts := &kclient.ListOptions{Namespace: ns}
+
+	switch resource {
+	case "pods":
+		list := &corev1.PodList{}
+		if err := g.Client.List(ctx, list, opts); err != nil {
+			return fmt.Errorf("couldn't list pods: %w", err)
+		}
+		for _, item := range list.Items {
+			if err := g.Writer.WriteNamespaced(ns, "pods", item.Name, &item); err != nil {
+				return err
+			}
+		}
+	case "deployments":
+		list := &appsv1.DeploymentList{}
+		if err := g.Client.List(ctx, list, opts); err != nil {
+			return fmt.Errorf("couldn't list deployments: %w", err)
+		}
+		for _, item := range list.Items {
+			if err := g.Writer.WriteNamespaced(ns, "deployments", item.Name, &item); err != nil {
+				return err
+			}
+		}
+	case "configmaps":
+		list := &corev1.ConfigMapList{}
+		if err := g.Client.List(ctx, list, opts); err != nil {
+			return fmt.Errorf("couldn't list configmaps: %w", err)
+		}
+		for _, item := range list.Items {
+			if err := g.Writer.WriteNamespaced(ns, "configmaps", item.Name, &item); err != nil {
+				return err
+			}
+		}
+	case "events":
+		list := &corev1.EventList{}
+		if err := g.Client.List(ctx, list, opts); err != nil {
+			return fmt.Errorf("couldn't list events: %w", err)
+		}
+		for _, item := range list.Items {
+			if err := g.Writer.WriteNamespaced(ns, "events", item.Name, &item); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported resource kind %q", resource)
+	}
+
+	return nil
+}
+
+// gatherPodLogs collects current (and, if configured, previous) container
+// logs for every pod in ns via the typed clientset's pods/log subresource.
+func (g *Gatherer) gatherPodLogs(ctx context.Context, ns string) error {
+	pods := &corev1.PodList{}
+	if err := g.Client.List(ctx, pods, &kclient.ListOptions{Namespace: ns}); err != nil {
+		return fmt.Errorf("couldn't list pods for log collection: %w", err)
+	}
+
+	for _, pod := range pods.Items {
+		for _, c := range pod.Spec.Containers {
+			if err := g.writePodLog(ctx, ns, pod.Name, c.Name, false); err != nil {
+				log.Printf("couldn't gather logs for %s/%s: %v", pod.Name, c.Name, err)
+			}
+			if g.Config.PreviousLogs {
+				if err := g.writePodLog(ctx, ns, pod.Name, c.Name, true); err != nil {
+					log.Printf("couldn't gather previous logs for %s/%s: %v", pod.Name, c.Name, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *Gatherer) writePodLog(ctx context.Context, ns, podName, containerName string, previous bool) error {
+	req := g.Clientset.CoreV1().Pods(ns).GetLogs(podName, &corev1.PodLogOptions{
+		Container: containerName,
+		Previous:  previous,
+	})
+
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't open log stream: %w", err)
+	}
+	defer stream.Close()
+
+	contents, err := io.ReadAll(stream)
+	if err != nil {
+		return fmt.Errorf("couldn't read log stream: %w", err)
+	}
+
+	return g.Writer.WriteLog(ns, podName, containerName, previous, contents)
+}
+
+func (g *Gatherer) gatherClusterScoped(ctx context.Context) error {
+	nodes := &corev1.NodeList{}
+	if err := g.Client.List(ctx, nodes, &kclient.ListOptions{}); err != nil {
+		return fmt.Errorf("couldn't list nodes: %w", err)
+	}
+	for _, node := range nodes.Items {
+		if err := g.Writer.WriteClusterScoped("nodes", node.Name, &node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
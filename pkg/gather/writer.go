@@ -0,0 +1,73 @@
+package gather
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ClusterWriter persists Kubernetes objects and raw log output to a
+// must-gather directory tree rooted at Dir. Namespaced resources are
+// written under namespaces/<ns>/<kind>/<name>.yaml and cluster-scoped
+// resources under cluster-scoped/<kind>/<name>.yaml.
+type ClusterWriter struct {
+	Dir string
+}
+
+// NewClusterWriter returns a ClusterWriter rooted at dir, creating dir if
+// it doesn't already exist.
+func NewClusterWriter(dir string) (*ClusterWriter, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("couldn't create output directory %q: %w", dir, err)
+	}
+	return &ClusterWriter{Dir: dir}, nil
+}
+
+// WriteNamespaced marshals obj to YAML and writes it to
+// <Dir>/namespaces/<namespace>/<kind>/<name>.yaml.
+func (w *ClusterWriter) WriteNamespaced(namespace, kind, name string, obj interface{}) error {
+	return w.write(filepath.Join(w.Dir, "namespaces", namespace, kind), name, obj)
+}
+
+// WriteClusterScoped marshals obj to YAML and writes it to
+// <Dir>/cluster-scoped/<kind>/<name>.yaml.
+func (w *ClusterWriter) WriteClusterScoped(kind, name string, obj interface{}) error {
+	return w.write(filepath.Join(w.Dir, "cluster-scoped", kind), name, obj)
+}
+
+// WriteLog writes raw container log output to
+// <Dir>/namespaces/<namespace>/pods/<podName>/<containerName>[-previous].log.
+func (w *ClusterWriter) WriteLog(namespace, podName, containerName string, previous bool, contents []byte) error {
+	dir := filepath.Join(w.Dir, "namespaces", namespace, "pods", podName)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("couldn't create log directory %q: %w", dir, err)
+	}
+
+	name := containerName + ".log"
+	if previous {
+		name = containerName + "-previous.log"
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), contents, 0o644); err != nil {
+		return fmt.Errorf("couldn't write log %q: %w", name, err)
+	}
+	return nil
+}
+
+func (w *ClusterWriter) write(dir, name string, obj interface{}) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("couldn't create directory %q: %w", dir, err)
+	}
+
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal %q to YAML: %w", name, err)
+	}
+
+	path := filepath.Join(dir, name+".yaml")
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("couldn't write %q: %w", path, err)
+	}
+	return nil
+}
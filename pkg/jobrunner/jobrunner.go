@@ -0,0 +1,283 @@
+// Package jobrunner dispatches one-off batchv1.Job remediation tasks
+// against a set of discovered Pods, bounding how many Jobs run
+// concurrently and tagging every Job it creates with a per-invocation
+// UUID so multiple instances of this binary don't collide.
+package jobrunner
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PreTaskAnnotation is the annotation a Pod can carry to request a command
+// be exec'd inside it before its remediation Job is dispatched.
+const PreTaskAnnotation = "example-managed-job/pre-task"
+
+// InvocationLabel tags every Job created by a single Dispatch call with a
+// shared UUID so concurrently-running instances of this binary don't
+// operate on each other's Jobs.
+const InvocationLabel = "example-managed-job/invocation"
+
+// ManagedByLabel and ManagedByValue identify every Job this tool creates,
+// so the CLI's list/suspend/resume/delete subcommands can find them.
+const (
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+	ManagedByValue = "example-managed-job"
+)
+
+// TargetPodLabel records which pod a remediation Job was dispatched for.
+const TargetPodLabel = "target-pod"
+
+// dns1123LabelMaxLength is the maximum length of a Kubernetes label value.
+const dns1123LabelMaxLength = 63
+
+// Config controls how the Dispatcher creates and waits for Jobs.
+type Config struct {
+	// Namespace is where remediation Jobs are created.
+	Namespace string
+	// Image is the container image run by each remediation Job.
+	Image string
+	// Command is the command run inside Image; the target pod's name is
+	// appended as its final argument.
+	Command []string
+	// Concurrency bounds how many Jobs are in-flight at once. Defaults to 1.
+	Concurrency int
+	// PollInterval controls how often Job status is polled. Defaults to 5s.
+	PollInterval time.Duration
+	// ExecContainer names the container the pre-task hook execs into. If
+	// empty, the pod's first container is used.
+	ExecContainer string
+}
+
+// Dispatcher creates and supervises remediation Jobs for discovered pods.
+type Dispatcher struct {
+	Client     kclient.Client
+	Clientset  kubernetes.Interface
+	RESTConfig *rest.Config
+	Config     Config
+}
+
+// NewDispatcher returns a Dispatcher, filling in unset Config defaults.
+func NewDispatcher(c kclient.Client, cs kubernetes.Interface, restConfig *rest.Config, cfg Config) *Dispatcher {
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 1
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 5 * time.Second
+	}
+	return &Dispatcher{Client: c, Clientset: cs, RESTConfig: restConfig, Config: cfg}
+}
+
+// Result records the outcome of dispatching a remediation Job for a
+// single pod.
+type Result struct {
+	PodName string
+	Err     error
+}
+
+// Dispatch runs a remediation Job for each pod in pods, allowing at most
+// Config.Concurrency Jobs in-flight at once. It returns once every Job has
+// completed (successfully or not), with one Result per pod in no
+// particular order; per-pod errors are logged rather than aborting the
+// whole batch.
+func (d *Dispatcher) Dispatch(ctx context.Context, pods []corev1.Pod) []Result {
+	invocationID := uuid.NewString()
+	sem := make(chan struct{}, d.Config.Concurrency)
+	results := make(chan Result, len(pods))
+
+	var wg sync.WaitGroup
+	for _, pod := range pods {
+		pod := pod
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			err := d.runOne(ctx, pod, invocationID)
+			if err != nil {
+				log.Printf("pod %s: %v", pod.Name, err)
+			}
+			results <- Result{PodName: pod.Name, Err: err}
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	out := make([]Result, 0, len(pods))
+	for r := range results {
+		out = append(out, r)
+	}
+	return out
+}
+
+func (d *Dispatcher) runOne(ctx context.Context, pod corev1.Pod, invocationID string) error {
+	if cmd, ok := pod.Annotations[PreTaskAnnotation]; ok && cmd != "" {
+		if err := d.execPreTask(ctx, pod, cmd); err != nil {
+			return fmt.Errorf("pre-task hook failed: %w", err)
+		}
+	}
+
+	job := d.buildJob(pod, invocationID)
+	if err := d.Client.Create(ctx, job); err != nil {
+		return fmt.Errorf("couldn't create job: %w", err)
+	}
+
+	status, err := d.waitForCompletion(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	if status == batchv1.JobFailed {
+		d.collectFailureLogs(ctx, job)
+	}
+
+	if err := d.Client.Delete(ctx, job, kclient.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		log.Printf("couldn't delete completed job %s: %v", job.Name, err)
+	}
+
+	if status == batchv1.JobFailed {
+		return fmt.Errorf("job %s failed", job.Name)
+	}
+	return nil
+}
+
+func (d *Dispatcher) buildJob(pod corev1.Pod, invocationID string) *batchv1.Job {
+	backoffLimit := int32(0)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("remediate-%s-", pod.Name),
+			Namespace:    d.Config.Namespace,
+			Labels: map[string]string{
+				InvocationLabel: invocationID,
+				ManagedByLabel:  ManagedByValue,
+				TargetPodLabel:  labelSafe(pod.Name),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:    "remediate",
+							Image:   d.Config.Image,
+							Command: append(append([]string{}, d.Config.Command...), pod.Name),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// labelSafe returns s unchanged if it already fits in a Kubernetes label
+// value (<= 63 chars). Otherwise it truncates s and appends a short hash
+// of the original value so the result stays unique and still fits,
+// rather than making Client.Create reject the Job outright.
+func labelSafe(s string) string {
+	if len(s) <= dns1123LabelMaxLength {
+		return s
+	}
+	sum := sha256.Sum256([]byte(s))
+	suffix := "-" + hex.EncodeToString(sum[:])[:8]
+	return s[:dns1123LabelMaxLength-len(suffix)] + suffix
+}
+
+func (d *Dispatcher) waitForCompletion(ctx context.Context, job *batchv1.Job) (batchv1.JobConditionType, error) {
+	ticker := time.NewTicker(d.Config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+			current := &batchv1.Job{}
+			if err := d.Client.Get(ctx, kclient.ObjectKeyFromObject(job), current); err != nil {
+				return "", fmt.Errorf("couldn't get job status: %w", err)
+			}
+			for _, cond := range current.Status.Conditions {
+				if cond.Status != corev1.ConditionTrue {
+					continue
+				}
+				if cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed {
+					return cond.Type, nil
+				}
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) collectFailureLogs(ctx context.Context, job *batchv1.Job) {
+	pods := &corev1.PodList{}
+	if err := d.Client.List(ctx, pods, kclient.InNamespace(job.Namespace), kclient.MatchingLabels{"job-name": job.Name}); err != nil {
+		log.Printf("couldn't list pods for failed job %s: %v", job.Name, err)
+		return
+	}
+
+	for _, pod := range pods.Items {
+		req := d.Clientset.CoreV1().Pods(job.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{})
+		stream, err := req.Stream(ctx)
+		if err != nil {
+			log.Printf("couldn't stream logs for %s: %v", pod.Name, err)
+			continue
+		}
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(stream)
+		stream.Close()
+		log.Printf("logs from failed job %s pod %s:\n%s", job.Name, pod.Name, buf.String())
+	}
+}
+
+func (d *Dispatcher) execPreTask(ctx context.Context, pod corev1.Pod, cmd string) error {
+	container := d.Config.ExecContainer
+	if container == "" {
+		if len(pod.Spec.Containers) == 0 {
+			return fmt.Errorf("pod %s has no containers to exec into", pod.Name)
+		}
+		container = pod.Spec.Containers[0].Name
+	}
+
+	req := d.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(pod.Namespace).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			// The API server rejects exec requests against a multi-container
+			// pod (e.g. a static pod with a cert-syncer sidecar) unless a
+			// container is named explicitly.
+			Container: container,
+			Command:   []string{"/bin/sh", "-c", cmd},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(d.RESTConfig, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("couldn't build exec executor: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := exec.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr}); err != nil {
+		return fmt.Errorf("exec failed: %w (stderr: %s)", err, stderr.String())
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package jobrunner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestLabelSafe(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+	}{
+		{"short value is unchanged", "apiserver-0"},
+		{"value over 63 chars is truncated with a hash suffix", strings.Repeat("a", 200)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := labelSafe(tc.in)
+			if len(out) > dns1123LabelMaxLength {
+				t.Fatalf("labelSafe(%q) = %q, longer than %d chars", tc.in, out, dns1123LabelMaxLength)
+			}
+			if len(tc.in) <= dns1123LabelMaxLength && out != tc.in {
+				t.Fatalf("labelSafe(%q) = %q, want unchanged", tc.in, out)
+			}
+		})
+	}
+}
+
+func TestBuildJobTruncatesLongPodNames(t *testing.T) {
+	d := &Dispatcher{Config: Config{Namespace: "openshift-kube-apiserver", Image: "img"}}
+	pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("x", 200)}}
+
+	job := d.buildJob(pod, "invocation-id")
+
+	if got := job.Labels[TargetPodLabel]; len(got) > dns1123LabelMaxLength {
+		t.Fatalf("target-pod label %q exceeds %d chars", got, dns1123LabelMaxLength)
+	}
+}
+
+// TestDispatchRespectsConcurrency verifies that Dispatch never has more
+// than Config.Concurrency Job creates in flight at once, using a fake
+// client whose Create intercept completes the Job immediately so the
+// poll loop returns right away.
+func TestDispatchRespectsConcurrency(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := batchv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	const concurrency = 2
+	var (
+		mu        sync.Mutex
+		active    int
+		maxActive int
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c kclient.WithWatch, obj kclient.Object, opts ...kclient.CreateOption) error {
+				mu.Lock()
+				active++
+				if active > maxActive {
+					maxActive = active
+				}
+				mu.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				if job, ok := obj.(*batchv1.Job); ok {
+					job.Status.Conditions = []batchv1.JobCondition{
+						{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+					}
+				}
+				err := c.Create(ctx, obj, opts...)
+
+				mu.Lock()
+				active--
+				mu.Unlock()
+				return err
+			},
+		}).
+		Build()
+
+	cs := fakeclientset.NewSimpleClientset()
+
+	d := NewDispatcher(c, cs, nil, Config{
+		Namespace:    "openshift-kube-apiserver",
+		Image:        "diagnostics",
+		Concurrency:  concurrency,
+		PollInterval: 2 * time.Millisecond,
+	})
+
+	pods := make([]corev1.Pod, 6)
+	for i := range pods {
+		pods[i] = corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("apiserver-%d", i), Namespace: d.Config.Namespace}}
+	}
+
+	results := d.Dispatch(context.Background(), pods)
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("pod %s: unexpected error: %v", r.PodName, r.Err)
+		}
+	}
+
+	if maxActive > concurrency {
+		t.Fatalf("observed %d concurrent Job creates, want at most %d", maxActive, concurrency)
+	}
+}
@@ -0,0 +1,117 @@
+// Package notify renders a run summary and fans it out to a pluggable
+// set of notification sinks (Slack, generic webhook, SMTP email) once
+// pod discovery (and, if configured, Job dispatch) completes.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"text/template"
+	"time"
+)
+
+// JobResult records the outcome of a single remediation Job dispatched
+// for a discovered pod.
+type JobResult struct {
+	PodName string
+	Success bool
+	Error   string
+}
+
+// Summary is the data rendered into each notifier's message template.
+type Summary struct {
+	ClusterName string
+	Namespace   string
+	PodsFound   []string
+	NotReady    []string
+	JobResults  []JobResult
+}
+
+// DefaultTemplate is used when Render is given an empty template. FanOut
+// renders a single message for all notifiers; a sink's
+// SinkConfig.Template is not applied.
+const DefaultTemplate = `example-managed-job run summary for cluster {{.ClusterName}}, namespace {{.Namespace}}
+Pods found: {{len .PodsFound}}
+Not Ready: {{len .NotReady}}{{range .NotReady}}
+  - {{.}}{{end}}
+{{- if .JobResults}}
+Job results:{{range .JobResults}}
+  - {{.PodName}}: {{if .Success}}succeeded{{else}}failed ({{.Error}}){{end}}{{end}}
+{{- end}}
+`
+
+// Render executes tmpl (or DefaultTemplate if empty) against s.
+func Render(tmpl string, s Summary) (string, error) {
+	if tmpl == "" {
+		tmpl = DefaultTemplate
+	}
+	t, err := template.New("summary").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, s); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Notifier delivers a rendered summary to a single sink.
+type Notifier interface {
+	// Notify delivers message. Implementations should respect ctx's
+	// deadline rather than imposing their own.
+	Notify(ctx context.Context, message string) error
+}
+
+// Options bounds how FanOut waits for and retries each Notifier.
+type Options struct {
+	// Timeout bounds a single delivery attempt. Defaults to 10s.
+	Timeout time.Duration
+	// Retries is how many additional attempts are made after a failure.
+	Retries int
+}
+
+// FanOut renders summary once and delivers it to every notifier
+// concurrently, applying opts.Timeout and opts.Retries to each. It
+// returns one error per notifier, in the same order as notifiers, with a
+// nil entry for notifiers that succeeded.
+func FanOut(ctx context.Context, notifiers []Notifier, summary Summary, tmpl string, opts Options) []error {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+
+	message, err := Render(tmpl, summary)
+	if err != nil {
+		errs := make([]error, len(notifiers))
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	errs := make([]error, len(notifiers))
+	done := make(chan struct{})
+	for i, n := range notifiers {
+		go func(i int, n Notifier) {
+			defer func() { done <- struct{}{} }()
+			errs[i] = deliverWithRetry(ctx, n, message, opts)
+		}(i, n)
+	}
+	for range notifiers {
+		<-done
+	}
+	return errs
+}
+
+func deliverWithRetry(ctx context.Context, n Notifier, message string, opts Options) error {
+	var lastErr error
+	for attempt := 0; attempt <= opts.Retries; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		lastErr = n.Notify(attemptCtx, message)
+		cancel()
+		if lastErr == nil {
+			return nil
+		}
+	}
+	return lastErr
+}
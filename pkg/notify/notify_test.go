@@ -0,0 +1,70 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRenderIncludesClusterAndNamespace(t *testing.T) {
+	summary := Summary{
+		ClusterName: "prod-east",
+		Namespace:   "openshift-kube-apiserver",
+		PodsFound:   []string{"apiserver-0", "apiserver-1"},
+		NotReady:    []string{"apiserver-1"},
+		JobResults: []JobResult{
+			{PodName: "apiserver-1", Success: false, Error: "boom"},
+		},
+	}
+
+	out, err := Render("", summary)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	for _, want := range []string{"prod-east", "openshift-kube-apiserver", "apiserver-1", "boom"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered summary %q missing %q", out, want)
+		}
+	}
+}
+
+type fakeNotifier struct {
+	failUntil int
+	calls     int
+	lastMsg   string
+}
+
+func (f *fakeNotifier) Notify(ctx context.Context, message string) error {
+	f.calls++
+	f.lastMsg = message
+	if f.calls <= f.failUntil {
+		return errors.New("not yet")
+	}
+	return nil
+}
+
+func TestFanOutRetriesAndReportsPerNotifier(t *testing.T) {
+	ok := &fakeNotifier{}
+	retriesThenOK := &fakeNotifier{failUntil: 1}
+	alwaysFails := &fakeNotifier{failUntil: 100}
+
+	errs := FanOut(context.Background(), []Notifier{ok, retriesThenOK, alwaysFails}, Summary{}, "", Options{Retries: 1})
+
+	if len(errs) != 3 {
+		t.Fatalf("len(errs) = %d, want 3", len(errs))
+	}
+	if errs[0] != nil {
+		t.Errorf("notifier 0: unexpected error %v", errs[0])
+	}
+	if errs[1] != nil {
+		t.Errorf("notifier 1: expected retry to succeed, got error %v", errs[1])
+	}
+	if errs[2] == nil {
+		t.Errorf("notifier 2: expected error after exhausting retries, got nil")
+	}
+	if retriesThenOK.calls != 2 {
+		t.Errorf("retriesThenOK.calls = %d, want 2", retriesThenOK.calls)
+	}
+}
@@ -0,0 +1,138 @@
+package notify
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// SinkConfig configures a single notifier sink. Type selects which
+// registered Factory builds it; the remaining fields are sink-specific
+// and left unset unless that sink needs them.
+type SinkConfig struct {
+	Type       string      `json:"type"`
+	WebhookURL string      `json:"webhookURL,omitempty"`
+	SMTP       *SMTPConfig `json:"smtp,omitempty"`
+	Template   string      `json:"template,omitempty"`
+}
+
+// SMTPConfig configures the smtp sink.
+type SMTPConfig struct {
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+}
+
+// Config is the top-level notify configuration, loaded from a YAML file
+// and then overridden by environment variables.
+type Config struct {
+	Sinks []SinkConfig `json:"sinks"`
+}
+
+// Factory builds a Notifier from a SinkConfig. Sinks register their
+// Factory via Register so new sinks can be added at compile time without
+// changing this package.
+type Factory func(SinkConfig) (Notifier, error)
+
+var registry = map[string]Factory{}
+
+// Register adds a Factory for sink type name. Called from each sink's
+// init so the registry is populated at compile time by whichever sink
+// packages the binary imports.
+func Register(name string, f Factory) {
+	registry[name] = f
+}
+
+// Build instantiates every sink in cfg.Sinks via its registered Factory.
+func Build(cfg Config) ([]Notifier, error) {
+	notifiers := make([]Notifier, 0, len(cfg.Sinks))
+	for _, sc := range cfg.Sinks {
+		f, ok := registry[sc.Type]
+		if !ok {
+			return nil, fmt.Errorf("no notifier registered for sink type %q", sc.Type)
+		}
+		n, err := f(sc)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't build %q notifier: %w", sc.Type, err)
+		}
+		notifiers = append(notifiers, n)
+	}
+	return notifiers, nil
+}
+
+// LoadConfig reads a YAML config file from path, then applies
+// NOTIFY_<index>_<FIELD>-style environment variable overrides on top of
+// it (index is the sink's position in the sinks list; FIELD is one of
+// TYPE, WEBHOOKURL, TEMPLATE, SMTP_HOST, SMTP_PORT, SMTP_USERNAME,
+// SMTP_PASSWORD, or SMTP_FROM).
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+
+	if path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return cfg, fmt.Errorf("couldn't read notify config %q: %w", path, err)
+		}
+		if err := yaml.Unmarshal(raw, &cfg); err != nil {
+			return cfg, fmt.Errorf("couldn't parse notify config %q: %w", path, err)
+		}
+	}
+
+	for i := range cfg.Sinks {
+		applyEnvOverrides(i, &cfg.Sinks[i])
+	}
+
+	return cfg, nil
+}
+
+func applyEnvOverrides(index int, sc *SinkConfig) {
+	if v, ok := os.LookupEnv(envKey(index, "TYPE")); ok {
+		sc.Type = v
+	}
+	if v, ok := os.LookupEnv(envKey(index, "WEBHOOKURL")); ok {
+		sc.WebhookURL = v
+	}
+	if v, ok := os.LookupEnv(envKey(index, "TEMPLATE")); ok {
+		sc.Template = v
+	}
+
+	smtp := sc.SMTP
+	if _, hasHost := os.LookupEnv(envKey(index, "SMTP_HOST")); hasHost && smtp == nil {
+		smtp = &SMTPConfig{}
+		sc.SMTP = smtp
+	}
+	if smtp == nil {
+		return
+	}
+	if v, ok := os.LookupEnv(envKey(index, "SMTP_HOST")); ok {
+		smtp.Host = v
+	}
+	if v, ok := os.LookupEnv(envKey(index, "SMTP_PORT")); ok {
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			log.Printf("ignoring invalid %s: %v", envKey(index, "SMTP_PORT"), err)
+		} else {
+			smtp.Port = port
+		}
+	}
+	if v, ok := os.LookupEnv(envKey(index, "SMTP_USERNAME")); ok {
+		smtp.Username = v
+	}
+	if v, ok := os.LookupEnv(envKey(index, "SMTP_PASSWORD")); ok {
+		smtp.Password = v
+	}
+	if v, ok := os.LookupEnv(envKey(index, "SMTP_FROM")); ok {
+		smtp.From = v
+	}
+}
+
+func envKey(index int, field string) string {
+	return strings.ToUpper(fmt.Sprintf("NOTIFY_%d_%s", index, field))
+}
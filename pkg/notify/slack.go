@@ -0,0 +1,14 @@
+package notify
+
+import "fmt"
+
+func init() {
+	Register("slack", func(sc SinkConfig) (Notifier, error) {
+		if sc.WebhookURL == "" {
+			return nil, fmt.Errorf("slack sink requires webhookURL")
+		}
+		// Slack incoming webhooks accept the same {"text": ...} payload as
+		// a generic webhook, so the slack sink is a thin wrapper around it.
+		return &WebhookNotifier{URL: sc.WebhookURL}, nil
+	})
+}
@@ -0,0 +1,45 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+)
+
+func init() {
+	Register("smtp", func(sc SinkConfig) (Notifier, error) {
+		if sc.SMTP == nil {
+			return nil, fmt.Errorf("smtp sink requires an smtp config block")
+		}
+		return &SMTPNotifier{Config: *sc.SMTP}, nil
+	})
+}
+
+// SMTPNotifier emails the rendered summary to Config.To.
+type SMTPNotifier struct {
+	Config SMTPConfig
+}
+
+// Notify implements Notifier.
+func (s *SMTPNotifier) Notify(ctx context.Context, message string) error {
+	addr := fmt.Sprintf("%s:%d", s.Config.Host, s.Config.Port)
+
+	var auth smtp.Auth
+	if s.Config.Username != "" {
+		auth = smtp.PlainAuth("", s.Config.Username, s.Config.Password, s.Config.Host)
+	}
+
+	body := fmt.Sprintf("Subject: example-managed-job run summary\r\n\r\n%s", message)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- smtp.SendMail(addr, auth, s.Config.From, s.Config.To, []byte(body))
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-errCh:
+		return err
+	}
+}